@@ -0,0 +1,58 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+// Package id defines the various types of identifiers that can be used to
+// lookup an endpoint.
+package id
+
+const (
+	// CiliumLocalIdPrefix is the prefix used to indicate that a local
+	// endpoint ID is being referred to
+	CiliumLocalIdPrefix = "cilium-local"
+
+	// CiliumGlobalIdPrefix is the prefix used to indicate that a global
+	// identity is being referred to
+	CiliumGlobalIdPrefix = "cilium-global"
+
+	// CNIAttachmentIdPrefix is the prefix used for the CNI attachment ID,
+	// which is the containerID optionally suffixed with the CNI interface
+	// name
+	CNIAttachmentIdPrefix = "cni-attachment-id"
+
+	// ContainerIdPrefix is the prefix used for container IDs
+	ContainerIdPrefix = "container-id"
+
+	// DockerEndpointPrefix is the prefix used for Docker endpoint IDs
+	DockerEndpointPrefix = "docker-endpoint"
+
+	// ContainerNamePrefix is the prefix used for container names
+	ContainerNamePrefix = "container-name"
+
+	// PodNamePrefix is the prefix used for Kubernetes pod names, in the
+	// form of namespace/pod-name
+	PodNamePrefix = "pod-name"
+
+	// CEPNamePrefix is the prefix used for CiliumEndpoint names, in the
+	// form of namespace/cep-name
+	CEPNamePrefix = "cep-name"
+
+	// IPv4Prefix is the prefix used for IPv4 addresses
+	IPv4Prefix = "ipv4"
+
+	// IPv6Prefix is the prefix used for IPv6 addresses
+	IPv6Prefix = "ipv6"
+
+	// CRISandboxIDPrefix is the prefix used for the CRI PodSandbox ID, as
+	// reported by containerd/CRI-O backed runtimes
+	CRISandboxIDPrefix = "cri-sandbox-id"
+
+	// PodAttachmentPrefix is the prefix used for the composite
+	// namespace/pod-name/attachment-name identifier, which disambiguates
+	// multiple endpoints attached to the same pod (e.g. Multus secondary
+	// interfaces)
+	PodAttachmentPrefix = "pod-attachment"
+)
+
+// Identifiers is a map of identifiers that can be used to lookup an endpoint,
+// keyed by the identifier's prefix.
+type Identifiers map[string]string
@@ -27,6 +27,13 @@ func (e *Endpoint) GetK8sPodName() string {
 
 // HumanString returns the endpoint's most human readable identifier as string
 func (e *Endpoint) HumanString() string {
+	// Pods with more than one endpoint (e.g. Multus secondary interfaces)
+	// share the same pod name, so prefer the attachment-qualified form
+	// whenever the endpoint was created for a specific attachment.
+	if e.networkAttachmentName != "" {
+		return e.GetK8sNamespacePodAttachment()
+	}
+
 	if cep := e.GetK8sNamespaceAndCEPName(); cep != "" {
 		return cep
 	}
@@ -41,6 +48,23 @@ func (e *Endpoint) GetK8sNamespaceAndPodName() string {
 	return e.K8sNamespace + "/" + e.K8sPodName
 }
 
+// GetNetworkAttachmentName returns the name of the network attachment
+// (e.g. a Multus NetworkAttachmentDefinition, as surfaced via the CNI
+// CNI_IFNAME) this endpoint was created for. Returns an empty string for
+// a pod's primary interface.
+func (e *Endpoint) GetNetworkAttachmentName() string {
+	// const after creation
+	return e.networkAttachmentName
+}
+
+// GetK8sNamespacePodAttachment returns the composite namespace/pod-name/
+// attachment-name identifier used to disambiguate multiple endpoints
+// attached to the same pod.
+func (e *Endpoint) GetK8sNamespacePodAttachment() string {
+	// all fields are const after creation
+	return e.K8sNamespace + "/" + e.K8sPodName + "/" + e.networkAttachmentName
+}
+
 // GetK8sCEPName returns the corresponding K8s CiliumEndpoint resource name
 // for this endpoint (without the namespace)
 // Returns an empty string if the endpoint does not belong to a pod.
@@ -49,8 +73,13 @@ func (e *Endpoint) GetK8sCEPName() string {
 
 	// Endpoints which have not opted out of legacy identifiers will continue
 	// to use just the pod name as the cep name for backwards compatibility reasons.
-	if e.disableLegacyIdentifiers && e.K8sPodName != "" && e.containerIfName != "" {
-		return e.K8sPodName + "-" + e.containerIfName
+	if e.disableLegacyIdentifiers && e.K8sPodName != "" {
+		if e.networkAttachmentName != "" {
+			return e.K8sPodName + "-" + e.networkAttachmentName
+		}
+		if e.containerIfName != "" {
+			return e.K8sPodName + "-" + e.containerIfName
+		}
 	}
 	return e.K8sPodName
 }
@@ -113,6 +142,20 @@ func (e *Endpoint) GetDockerEndpointID() string {
 	return e.dockerEndpointID
 }
 
+// GetCRISandboxID returns the endpoint's CRI PodSandbox ID, if any.
+func (e *Endpoint) GetCRISandboxID() string {
+	e.unconditionalRLock()
+	defer e.runlock()
+	return e.criSandboxID
+}
+
+// SetCRISandboxID sets the endpoint's CRI PodSandbox ID.
+func (e *Endpoint) SetCRISandboxID(criSandboxID string) {
+	e.unconditionalLock()
+	e.criSandboxID = criSandboxID
+	e.unlock()
+}
+
 // IdentifiersLocked fetches the set of attributes that uniquely identify the
 // endpoint. The caller must hold exclusive control over the endpoint.
 func (e *Endpoint) IdentifiersLocked() id.Identifiers {
@@ -129,6 +172,10 @@ func (e *Endpoint) IdentifiersLocked() id.Identifiers {
 		refs[id.DockerEndpointPrefix] = e.dockerEndpointID
 	}
 
+	if e.criSandboxID != "" {
+		refs[id.CRISandboxIDPrefix] = e.criSandboxID
+	}
+
 	if e.IPv4.IsValid() {
 		refs[id.IPv4Prefix] = e.IPv4.String()
 	}
@@ -145,6 +192,14 @@ func (e *Endpoint) IdentifiersLocked() id.Identifiers {
 		refs[id.PodNamePrefix] = podName
 	}
 
+	// Multiple endpoints (e.g. Multus secondary interfaces) can share the
+	// same pod name, so also register a composite key that disambiguates
+	// by attachment. This is added alongside, not instead of, the legacy
+	// pod-name entry above.
+	if e.networkAttachmentName != "" && e.K8sPodName != "" {
+		refs[id.PodAttachmentPrefix] = e.GetK8sNamespacePodAttachment()
+	}
+
 	if cepName := e.GetK8sNamespaceAndCEPName(); cepName != "" {
 		refs[id.CEPNamePrefix] = cepName
 	}